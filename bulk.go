@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// exportConfigs downloads every Generic Credential under \VED\Policy matching
+// selector into dir, one YAML file per credential, laid out to mirror the
+// credential's DN so operators can treat it as a GitOps source of truth.
+func exportConfigs(tppURL, token, dir, selector string, concurrency int) error {
+	credPaths, err := listObjects(tppURL, token)
+	if err != nil {
+		return err
+	}
+	credPaths, err = filterBySelector(credPaths, selector)
+	if err != nil {
+		return err
+	}
+
+	return runWorkers(credPaths, concurrency, func(credPath string) error {
+		credResp, err := getCred(tppURL, token, credPath)
+		if err != nil {
+			return fmt.Errorf("while fetching %q: %w", credPath, err)
+		}
+		if len(credResp.Values) == 0 {
+			return fmt.Errorf("no values found in %q", credPath)
+		}
+		yamlBlob, err := base64.StdEncoding.DecodeString(credResp.Values[0].Value)
+		if err != nil {
+			return fmt.Errorf("while decoding %q: %w", credPath, err)
+		}
+
+		path := credPathToFile(dir, credPath)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		return os.WriteFile(path, yamlBlob, 0o600)
+	})
+}
+
+// applyConfigs uploads every <dir>/<DN>.yaml file matching selector back to
+// TPP, only calling updateCred for files that differ from the current remote
+// value.
+func applyConfigs(tppURL, token, dir, selector string, concurrency int) error {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".yaml" {
+			return nil
+		}
+		files = append(files, path)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("while walking %q: %w", dir, err)
+	}
+
+	var credPaths []string
+	fileForCredPath := map[string]string{}
+	for _, f := range files {
+		credPath, err := fileToCredPath(dir, f)
+		if err != nil {
+			return err
+		}
+		credPaths = append(credPaths, credPath)
+		fileForCredPath[credPath] = f
+	}
+	credPaths, err = filterBySelector(credPaths, selector)
+	if err != nil {
+		return err
+	}
+
+	return runWorkers(credPaths, concurrency, func(credPath string) error {
+		localYAML, err := os.ReadFile(fileForCredPath[credPath])
+		if err != nil {
+			return err
+		}
+
+		credResp, err := getCred(tppURL, token, credPath)
+		if err != nil {
+			return fmt.Errorf("while fetching %q: %w", credPath, err)
+		}
+		if len(credResp.Values) == 0 {
+			return fmt.Errorf("no values found in %q", credPath)
+		}
+		remoteYAML, err := base64.StdEncoding.DecodeString(credResp.Values[0].Value)
+		if err != nil {
+			return fmt.Errorf("while decoding %q: %w", credPath, err)
+		}
+
+		if bytes.Equal(localYAML, remoteYAML) {
+			return nil
+		}
+
+		credResp.Values[0].Value = base64.StdEncoding.EncodeToString(localYAML)
+		if err := updateCred(tppURL, token, credPath, *credResp); err != nil {
+			return fmt.Errorf("while updating %q: %w", credPath, err)
+		}
+		fmt.Printf("updated %s\n", credPath)
+		return nil
+	})
+}
+
+// credPathToFile maps a TPP DN like '\VED\Policy\Firefly\foo' to
+// '<dir>/VED/Policy/Firefly/foo.yaml', mirroring the DN hierarchy as nested
+// directories.
+func credPathToFile(dir, credPath string) string {
+	rel := strings.TrimPrefix(credPath, `\`)
+	rel = strings.ReplaceAll(rel, `\`, "/")
+	return filepath.Join(dir, rel+".yaml")
+}
+
+// fileToCredPath is the inverse of credPathToFile.
+func fileToCredPath(dir, path string) (string, error) {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return "", fmt.Errorf("while computing path relative to %q: %w", dir, err)
+	}
+	rel = strings.TrimSuffix(rel, ".yaml")
+	rel = strings.ReplaceAll(filepath.ToSlash(rel), "/", `\`)
+	return `\` + rel, nil
+}
+
+// filterBySelector keeps only the credPaths matching the DN glob selector
+// (e.g. '\VED\Policy\Firefly\*'). An empty selector matches everything.
+func filterBySelector(credPaths []string, selector string) ([]string, error) {
+	if selector == "" {
+		return credPaths, nil
+	}
+	var out []string
+	for _, credPath := range credPaths {
+		ok, err := dnGlobMatch(selector, credPath)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --selector %q: %w", selector, err)
+		}
+		if ok {
+			out = append(out, credPath)
+		}
+	}
+	return out, nil
+}
+
+// dnGlobMatch reports whether credPath matches a DN glob such as
+// '\VED\Policy\Firefly\*'. DNs are '\'-separated, so matching is done
+// segment-by-segment with path.Match rather than filepath.Match, which on
+// non-Windows treats '\' as an escape character and would never match a real
+// DN.
+func dnGlobMatch(pattern, credPath string) (bool, error) {
+	patternSegs := strings.Split(pattern, `\`)
+	credSegs := strings.Split(credPath, `\`)
+	if len(patternSegs) != len(credSegs) {
+		return false, nil
+	}
+	for i, seg := range patternSegs {
+		ok, err := path.Match(seg, credSegs[i])
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// runWorkers runs fn over items using up to concurrency goroutines, returning
+// the first error encountered, if any, once all workers have finished.
+func runWorkers(items []string, concurrency int, fn func(string) error) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	jobs := make(chan string)
+	errs := make(chan error, len(items))
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range jobs {
+				errs <- fn(item)
+			}
+		}()
+	}
+	for _, item := range items {
+		jobs <- item
+	}
+	close(jobs)
+	wg.Wait()
+	close(errs)
+
+	var firstErr error
+	for err := range errs {
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,76 @@
+package main
+
+import "testing"
+
+func TestDnGlobMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		credPath string
+		want     bool
+		wantErr  bool
+	}{
+		{
+			name:     "trailing star matches a leaf",
+			pattern:  `\VED\Policy\Firefly\*`,
+			credPath: `\VED\Policy\Firefly\foo`,
+			want:     true,
+		},
+		{
+			name:     "trailing star does not match a deeper path",
+			pattern:  `\VED\Policy\Firefly\*`,
+			credPath: `\VED\Policy\Firefly\foo\bar`,
+			want:     false,
+		},
+		{
+			name:     "exact match with no wildcard",
+			pattern:  `\VED\Policy\Firefly\foo`,
+			credPath: `\VED\Policy\Firefly\foo`,
+			want:     true,
+		},
+		{
+			name:     "mismatched segment",
+			pattern:  `\VED\Policy\Firefly\*`,
+			credPath: `\VED\Policy\Other\foo`,
+			want:     false,
+		},
+		{
+			name:     "malformed pattern",
+			pattern:  `\VED\Policy\Firefly\[`,
+			credPath: `\VED\Policy\Firefly\foo`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dnGlobMatch(tt.pattern, tt.credPath)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("dnGlobMatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("dnGlobMatch(%q, %q) = %v, want %v", tt.pattern, tt.credPath, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCredPathToFileRoundTrip(t *testing.T) {
+	credPath := `\VED\Policy\Firefly\foo`
+	file := credPathToFile("/tmp/out", credPath)
+	want := "/tmp/out/VED/Policy/Firefly/foo.yaml"
+	if file != want {
+		t.Fatalf("credPathToFile() = %q, want %q", file, want)
+	}
+
+	got, err := fileToCredPath("/tmp/out", file)
+	if err != nil {
+		t.Fatalf("fileToCredPath() returned error: %v", err)
+	}
+	if got != credPath {
+		t.Errorf("fileToCredPath() = %q, want %q", got, credPath)
+	}
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// changeSummary is the structured added/removed/modified key summary computed
+// by walking two parsed YAML documents, rather than diffing their text.
+type changeSummary struct {
+	Added    []string
+	Removed  []string
+	Modified []string
+}
+
+func (s changeSummary) HasChanges() bool {
+	return len(s.Added) > 0 || len(s.Removed) > 0 || len(s.Modified) > 0
+}
+
+func (s changeSummary) String() string {
+	var b strings.Builder
+	for _, k := range s.Added {
+		fmt.Fprintf(&b, "+ %s\n", k)
+	}
+	for _, k := range s.Removed {
+		fmt.Fprintf(&b, "- %s\n", k)
+	}
+	for _, k := range s.Modified {
+		fmt.Fprintf(&b, "~ %s\n", k)
+	}
+	return b.String()
+}
+
+// summarizeYAMLChanges walks the top-level keys of 'before' and 'after' and
+// classifies each as added, removed or modified.
+func summarizeYAMLChanges(before, after []byte) (changeSummary, error) {
+	beforeMap, err := yamlToMap(before)
+	if err != nil {
+		return changeSummary{}, fmt.Errorf("while parsing original YAML: %w", err)
+	}
+	afterMap, err := yamlToMap(after)
+	if err != nil {
+		return changeSummary{}, fmt.Errorf("while parsing edited YAML: %w", err)
+	}
+
+	var summary changeSummary
+	for k := range afterMap {
+		if _, ok := beforeMap[k]; !ok {
+			summary.Added = append(summary.Added, k)
+		}
+	}
+	for k, beforeVal := range beforeMap {
+		afterVal, ok := afterMap[k]
+		if !ok {
+			summary.Removed = append(summary.Removed, k)
+			continue
+		}
+		if !valueEqual(beforeVal, true, afterVal, true) {
+			summary.Modified = append(summary.Modified, k)
+		}
+	}
+	sort.Strings(summary.Added)
+	sort.Strings(summary.Removed)
+	sort.Strings(summary.Modified)
+	return summary, nil
+}
+
+// unifiedDiff renders a minimal unified diff between 'before' and 'after',
+// good enough for eyeballing a Firefly config change in a terminal or CI log.
+func unifiedDiff(path string, before, after []byte) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- a/%s\n+++ b/%s\n", path, path)
+
+	for _, op := range diffLines(splitLines(before), splitLines(after)) {
+		switch op.kind {
+		case diffEqual:
+			fmt.Fprintf(&b, "  %s\n", op.line)
+		case diffRemove:
+			fmt.Fprintf(&b, "- %s\n", op.line)
+		case diffAdd:
+			fmt.Fprintf(&b, "+ %s\n", op.line)
+		}
+	}
+	return b.String()
+}
+
+func splitLines(data []byte) []string {
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	var lines []string
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines
+}
+
+type diffOpKind int
+
+const (
+	diffEqual diffOpKind = iota
+	diffRemove
+	diffAdd
+)
+
+type diffOp struct {
+	kind diffOpKind
+	line string
+}
+
+// diffLines computes a minimal line-level diff using the standard
+// longest-common-subsequence algorithm.
+func diffLines(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{diffEqual, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{diffRemove, a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{diffAdd, b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, diffOp{diffRemove, a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, diffOp{diffAdd, b[j]})
+	}
+	return ops
+}
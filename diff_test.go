@@ -0,0 +1,61 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want []diffOp
+	}{
+		{
+			name: "identical",
+			a:    []string{"one", "two", "three"},
+			b:    []string{"one", "two", "three"},
+			want: []diffOp{
+				{diffEqual, "one"},
+				{diffEqual, "two"},
+				{diffEqual, "three"},
+			},
+		},
+		{
+			name: "line added in the middle",
+			a:    []string{"one", "three"},
+			b:    []string{"one", "two", "three"},
+			want: []diffOp{
+				{diffEqual, "one"},
+				{diffAdd, "two"},
+				{diffEqual, "three"},
+			},
+		},
+		{
+			name: "line removed from the middle",
+			a:    []string{"one", "two", "three"},
+			b:    []string{"one", "three"},
+			want: []diffOp{
+				{diffEqual, "one"},
+				{diffRemove, "two"},
+				{diffEqual, "three"},
+			},
+		},
+		{
+			name: "empty inputs",
+			a:    nil,
+			b:    nil,
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffLines(tt.a, tt.b)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffLines(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -10,7 +10,12 @@ import (
 	"net/http"
 	"os"
 	"os/exec"
+	"reflect"
+	"sort"
 	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -32,9 +37,27 @@ func main() {
 
 	listCmd := flag.NewFlagSet("ls", flag.ExitOnError)
 	editCmd := flag.NewFlagSet("edit", flag.ExitOnError)
+	editDryRun := editCmd.Bool("dry-run", false, "print a diff instead of saving, exit non-zero if there are differences")
+	editFile := editCmd.String("file", "", "path to a local YAML file to use instead of opening $EDITOR; skips the interactive conflict/validation re-edit loop, so it's safe to use in CI")
+	validateCmd := flag.NewFlagSet("validate", flag.ExitOnError)
+	diffCmd := flag.NewFlagSet("diff", flag.ExitOnError)
+	diffFile := diffCmd.String("file", "", "path to a local YAML file to diff against instead of opening $EDITOR")
+	exportCmd := flag.NewFlagSet("export", flag.ExitOnError)
+	exportSelector := exportCmd.String("selector", "", `DN glob to restrict which credentials are exported, e.g. '\VED\Policy\Firefly\*'`)
+	exportConcurrency := exportCmd.Int("concurrency", 4, "number of credentials to download concurrently")
+	applyCmd := flag.NewFlagSet("apply", flag.ExitOnError)
+	applySelector := applyCmd.String("selector", "", `DN glob to restrict which credentials are applied, e.g. '\VED\Policy\Firefly\*'`)
+	applyConcurrency := applyCmd.Int("concurrency", 4, "number of credentials to upload concurrently")
+	serveCmd := flag.NewFlagSet("serve", flag.ExitOnError)
+	serveAddr := serveCmd.String("addr", ":8443", "address to listen on")
+	serveTLSCert := serveCmd.String("tls-cert", "", "path to a TLS certificate; TLS is disabled if unset")
+	serveTLSKey := serveCmd.String("tls-key", "", "path to the TLS certificate's private key")
+	serveBearerToken := serveCmd.String("bearer-token", "", "bearer token required from callers")
+	serveRateLimit := serveCmd.Float64("rate-limit", 10, "requests per second allowed across all callers")
+	serveWatchPeriod := serveCmd.Duration("watch-period", 5*time.Second, "how often WatchCredentials polls TPP for changes")
 
 	if len(os.Args) < 2 {
-		fmt.Println("Expected 'ls' or 'edit' subcommands")
+		fmt.Println("Expected 'ls', 'edit', 'validate', 'diff', 'export', 'apply' or 'serve' subcommands")
 		os.Exit(1)
 	}
 
@@ -55,7 +78,80 @@ func main() {
 			fmt.Println("Expected configuration name")
 			os.Exit(1)
 		}
-		if err := editConfigInCred(tppURL, token, editCmd.Arg(0)); err != nil {
+		changed, err := editConfigInCred(tppURL, token, editCmd.Arg(0), *editDryRun, *editFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if *editDryRun && changed {
+			os.Exit(1)
+		}
+	case "diff":
+		diffCmd.Parse(os.Args[2:])
+		if diffCmd.NArg() < 1 {
+			fmt.Println("Expected configuration name")
+			os.Exit(1)
+		}
+		changed, err := editConfigInCred(tppURL, token, diffCmd.Arg(0), true, *diffFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if changed {
+			os.Exit(1)
+		}
+	case "export":
+		exportCmd.Parse(os.Args[2:])
+		if exportCmd.NArg() < 1 {
+			fmt.Println("Expected a directory to export into")
+			os.Exit(1)
+		}
+		if err := exportConfigs(tppURL, token, exportCmd.Arg(0), *exportSelector, *exportConcurrency); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	case "apply":
+		applyCmd.Parse(os.Args[2:])
+		if applyCmd.NArg() < 1 {
+			fmt.Println("Expected a directory to apply from")
+			os.Exit(1)
+		}
+		if err := applyConfigs(tppURL, token, applyCmd.Arg(0), *applySelector, *applyConcurrency); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	case "validate":
+		validateCmd.Parse(os.Args[2:])
+		if validateCmd.NArg() < 1 {
+			fmt.Println("Expected a path to a YAML file")
+			os.Exit(1)
+		}
+		data, err := os.ReadFile(validateCmd.Arg(0))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if errs := validateConfig(data); len(errs) > 0 {
+			for _, e := range errs {
+				fmt.Fprintln(os.Stderr, e)
+			}
+			os.Exit(1)
+		}
+	case "serve":
+		serveCmd.Parse(os.Args[2:])
+		if *serveBearerToken == "" {
+			fmt.Println("Expected -bearer-token to be set")
+			os.Exit(1)
+		}
+		cfg := serveConfig{
+			addr:        *serveAddr,
+			tlsCertFile: *serveTLSCert,
+			tlsKeyFile:  *serveTLSKey,
+			bearerToken: *serveBearerToken,
+			rateLimit:   *serveRateLimit,
+			watchPeriod: *serveWatchPeriod,
+		}
+		if err := runServer(tppURL, token, cfg); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
@@ -65,6 +161,31 @@ func main() {
 	}
 }
 
+// Result is a TPP WebSDK Config API result code, as returned in the 'Result'
+// field of credential retrieve/update responses.
+type Result int
+
+const (
+	ResultSuccess           Result = 1
+	ResultAttributeNotFound Result = 102
+)
+
+// resultMessages maps known TPP Config API result codes to a human-readable
+// description.
+var resultMessages = map[Result]string{
+	ResultSuccess:           "success",
+	ResultAttributeNotFound: "attribute does not exist",
+}
+
+// ResultString renders a TPP Result code as a human-readable string, falling
+// back to "unknown result code <n>" for ones not in resultMessages.
+func ResultString(r Result) string {
+	if msg, ok := resultMessages[r]; ok {
+		return msg
+	}
+	return fmt.Sprintf("unknown result code %d", int(r))
+}
+
 type Credential struct {
 	Classname    string    `json:"Classname"`
 	Contact      []Contact `json:"Contact"`
@@ -121,67 +242,311 @@ func getCred(apiURL, token, credPath string) (*Credential, error) {
 	return &cred, nil
 }
 
-func editConfigInCred(tppURL, token, credPath string) error {
+// editConfigInCred opens credPath's YAML in $EDITOR and saves it back to TPP.
+// When dryRun is true, nothing is saved: instead a unified diff and a
+// structured added/removed/modified summary are printed, and the returned
+// bool reports whether the edit produced any difference from the remote
+// value, so callers can fail CI on drift.
+//
+// When localFile is non-empty, it is read as the edited YAML instead of
+// opening $EDITOR, so diff/--dry-run can run non-interactively in CI to
+// compare a checked-in YAML file against the live TPP credential. In that
+// case a conflict or a validation failure is returned as an error instead of
+// reopening an editor, since there's no user to resolve it interactively.
+func editConfigInCred(tppURL, token, credPath string, dryRun bool, localFile string) (bool, error) {
+	interactive := localFile == ""
 	credResp, err := getCred(tppURL, token, credPath)
 	if err != nil {
-		return err
+		return false, err
 	}
 	switch Result(credResp.Result) {
 	case ResultSuccess:
 		// continue
 	case ResultAttributeNotFound:
-		return fmt.Errorf("attribute not found: '%s'", credPath)
+		return false, fmt.Errorf("attribute not found: '%s'", credPath)
 	default:
-		return fmt.Errorf("error fetching '%s': %v", credPath, ResultString(credResp.Result))
+		return false, fmt.Errorf("error fetching '%s': %v", credPath, ResultString(credResp.Result))
 	}
 
 	// Get the Values[0].Value, and base64-decode it. This is the YAML blob that
 	// we want to edit.
 	if len(credResp.Values) == 0 {
-		return fmt.Errorf("no values found in '%s'", credPath)
+		return false, fmt.Errorf("no values found in '%s'", credPath)
 	}
-	yamlBlob, err := base64.StdEncoding.DecodeString(credResp.Values[0].Value)
+	originalYAML, err := base64.StdEncoding.DecodeString(credResp.Values[0].Value)
 	if err != nil {
-		return fmt.Errorf("error base64-decoding the field 'Values[0].Value': %w", err)
+		return false, fmt.Errorf("error base64-decoding the field 'Values[0].Value': %w", err)
 	}
 
 	tmpfile, err := os.CreateTemp("", "vcp-*.yaml")
 	if err != nil {
-		return err
+		return false, err
 	}
 	defer os.Remove(tmpfile.Name())
-	if _, err := tmpfile.Write(yamlBlob); err != nil {
-		return err
+	tmpPath := tmpfile.Name()
+	if _, err := tmpfile.Write(originalYAML); err != nil {
+		return false, err
 	}
 	tmpfile.Close()
 
-	// Open editor to let you edit YAML.
 	editor := os.Getenv("EDITOR")
 	if editor == "" {
 		editor = "vim"
 	}
-	cmd := exec.Command(editor, tmpfile.Name())
+
+	// base is the YAML we last showed the user, i.e. the common ancestor for
+	// the next three-way merge. It is advanced to the remote value every time
+	// we loop back for conflict resolution, kubectl-apply style.
+	base := originalYAML
+	for {
+		var editedYAML []byte
+		if interactive {
+			if err := runEditor(editor, tmpPath); err != nil {
+				return false, err
+			}
+			editedYAML, err = os.ReadFile(tmpPath)
+			if err != nil {
+				return false, err
+			}
+		} else {
+			editedYAML, err = os.ReadFile(localFile)
+			if err != nil {
+				return false, fmt.Errorf("while reading -file %q: %w", localFile, err)
+			}
+		}
+
+		// checkCredentialUpdate re-fetches the remote value, so it also
+		// doubles as detecting whether someone else saved a change to this
+		// credential while we were editing it.
+		remoteResp, remoteYAML, conflicts, editedMap, remoteMap, errs, err := checkCredentialUpdate(tppURL, token, credPath, base, editedYAML)
+		if err != nil {
+			return false, err
+		}
+
+		if len(conflicts) > 0 {
+			if !interactive {
+				return false, fmt.Errorf("'%s' was changed concurrently; conflict(s) in key(s): %s", credPath, strings.Join(conflicts, ", "))
+			}
+
+			preserved, preserveErr := preserveEdit(editedYAML)
+			if preserveErr != nil {
+				return false, preserveErr
+			}
+			fmt.Fprintf(os.Stderr, "error: '%s' was changed concurrently; %d conflict(s) marked with '# CONFLICT' below, please resolve and save again\nEDIT_PRESERVED=%s\n", credPath, len(conflicts), preserved)
+
+			annotated := annotateConflicts(editedYAML, conflicts, editedMap, remoteMap)
+			if err := os.WriteFile(tmpPath, annotated, 0o600); err != nil {
+				return false, err
+			}
+			credResp = remoteResp
+			base = remoteYAML
+			continue
+		}
+
+		if len(errs) > 0 {
+			if !interactive {
+				return false, fmt.Errorf("'%s' failed validation: %s", credPath, strings.Join(errs, "; "))
+			}
+
+			annotated := injectValidationErrors(editedYAML, errs)
+			if err := os.WriteFile(tmpPath, annotated, 0o600); err != nil {
+				return false, err
+			}
+			fmt.Fprintf(os.Stderr, "error: '%s' failed validation, please fix %d error(s) below and save again\n", credPath, len(errs))
+			credResp = remoteResp
+			base = remoteYAML
+			continue
+		}
+
+		if dryRun {
+			summary, err := summarizeYAMLChanges(remoteYAML, editedYAML)
+			if err != nil {
+				return false, fmt.Errorf("while summarizing changes to '%s': %w", credPath, err)
+			}
+			fmt.Print(unifiedDiff(credPath, remoteYAML, editedYAML))
+			fmt.Print(summary.String())
+			return summary.HasChanges(), nil
+		}
+
+		credResp = remoteResp
+		credResp.Values[0].Value = base64.StdEncoding.EncodeToString(editedYAML)
+		if err := updateCred(tppURL, token, credPath, *credResp); err != nil {
+			return false, fmt.Errorf("while patching Firefly configuration: %w", err)
+		}
+		return false, nil
+	}
+}
+
+// runEditor opens $EDITOR on path and blocks until the user closes it.
+func runEditor(editor, path string) error {
+	cmd := exec.Command(editor, path)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
-	if err := cmd.Run(); err != nil {
-		return err
+	return cmd.Run()
+}
+
+// preserveEdit saves content to a new temp file so it isn't lost across a
+// re-edit loop, mirroring kubectl's EDIT_PRESERVED behavior.
+func preserveEdit(content []byte) (string, error) {
+	f, err := os.CreateTemp("", "vcp-*.yaml")
+	if err != nil {
+		return "", fmt.Errorf("while preserving edit: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(content); err != nil {
+		return "", fmt.Errorf("while preserving edit: %w", err)
 	}
+	return f.Name(), nil
+}
 
-	// Read and parse the modified YAML.
-	yamlBlob, err = os.ReadFile(tmpfile.Name())
+// checkCredentialUpdate fetches credPath's current remote value and checks a
+// proposed newYAML against it before a save: detectConflicts compares it to
+// base (the value the caller last saw) to catch a concurrent edit, and
+// validateConfig checks it against the schema registry, skipped when there's
+// already a conflict to resolve. Both 'tppctl edit's three-way merge loop and
+// the gRPC UpdateCredential RPC call this, so a caller going through the
+// sidecar gets the same protections as the CLI.
+func checkCredentialUpdate(tppURL, token, credPath string, base, newYAML []byte) (remoteResp *Credential, remoteYAML []byte, conflicts []string, editedMap, remoteMap map[string]interface{}, validationErrs []string, err error) {
+	remoteResp, err = getCred(tppURL, token, credPath)
 	if err != nil {
-		return err
+		return nil, nil, nil, nil, nil, nil, err
+	}
+	if len(remoteResp.Values) == 0 {
+		return nil, nil, nil, nil, nil, nil, fmt.Errorf("no values found in '%s'", credPath)
+	}
+	remoteYAML, err = base64.StdEncoding.DecodeString(remoteResp.Values[0].Value)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, fmt.Errorf("error base64-decoding the field 'Values[0].Value': %w", err)
+	}
+
+	// detectConflicts parses the documents into maps only to compare them and
+	// never marshals one back, so the common case (remote unchanged, or
+	// changed in a way that doesn't overlap with the proposed edit) saves
+	// newYAML's bytes unchanged, comments and key order included.
+	conflicts, editedMap, remoteMap, err = detectConflicts(base, newYAML, remoteYAML)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, fmt.Errorf("while merging '%s': %w", credPath, err)
+	}
+
+	if len(conflicts) == 0 {
+		validationErrs = validateConfig(newYAML)
 	}
 
-	credResp.Values[0].Value = base64.StdEncoding.EncodeToString(yamlBlob)
+	return remoteResp, remoteYAML, conflicts, editedMap, remoteMap, validationErrs, nil
+}
 
-	err = updateCred(tppURL, token, credPath, *credResp)
+// detectConflicts compares the top-level keys of 'original', 'edited' and
+// 'remote' and reports which keys were independently changed on both the
+// 'edited' and 'remote' side to different values, the same rule kubectl uses
+// for strategic merge patches. It never marshals a merged document: callers
+// that find no conflicts should save 'edited' unchanged, so a save never
+// reflows the user's comments or key order. The returned maps let the caller
+// annotate 'edited' with both sides of any conflict.
+func detectConflicts(original, edited, remote []byte) (conflicts []string, editedMap, remoteMap map[string]interface{}, err error) {
+	originalMap, err := yamlToMap(original)
 	if err != nil {
-		return fmt.Errorf("while patching Firefly configuration: %w", err)
+		return nil, nil, nil, fmt.Errorf("while parsing original YAML: %w", err)
+	}
+	editedMap, err = yamlToMap(edited)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("while parsing edited YAML: %w", err)
+	}
+	remoteMap, err = yamlToMap(remote)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("while parsing remote YAML: %w", err)
 	}
 
-	return nil
+	keys := map[string]bool{}
+	for _, m := range []map[string]interface{}{originalMap, editedMap, remoteMap} {
+		for k := range m {
+			keys[k] = true
+		}
+	}
+
+	for k := range keys {
+		origVal, inOrig := originalMap[k]
+		editVal, inEdit := editedMap[k]
+		remoteVal, inRemote := remoteMap[k]
+
+		editChanged := !valueEqual(origVal, inOrig, editVal, inEdit)
+		remoteChanged := !valueEqual(origVal, inOrig, remoteVal, inRemote)
+
+		if editChanged && remoteChanged && !valueEqual(editVal, inEdit, remoteVal, inRemote) {
+			conflicts = append(conflicts, k)
+		}
+	}
+	sort.Strings(conflicts)
+
+	return conflicts, editedMap, remoteMap, nil
+}
+
+// yamlToMap parses a YAML document into a map, treating an empty document as
+// an empty map rather than an error.
+func yamlToMap(data []byte) (map[string]interface{}, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return map[string]interface{}{}, nil
+	}
+	var m map[string]interface{}
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	if m == nil {
+		m = map[string]interface{}{}
+	}
+	return m, nil
+}
+
+func valueEqual(a interface{}, aOk bool, b interface{}, bOk bool) bool {
+	if aOk != bOk {
+		return false
+	}
+	if !aOk {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// annotateConflicts inserts '# CONFLICT' comment blocks above each conflicting
+// key in doc, showing both sides of the conflict so the user can pick one.
+func annotateConflicts(doc []byte, conflicts []string, editedMap, remoteMap map[string]interface{}) []byte {
+	conflictSet := map[string]bool{}
+	for _, k := range conflicts {
+		conflictSet[k] = true
+	}
+
+	lines := strings.Split(string(doc), "\n")
+	out := make([]string, 0, len(lines)+4*len(conflicts))
+	annotated := map[string]bool{}
+	for _, line := range lines {
+		for key := range conflictSet {
+			if strings.HasPrefix(line, key+":") {
+				out = append(out,
+					fmt.Sprintf("# CONFLICT: both your edit and the remote changed %q", key),
+					fmt.Sprintf("#   your edit: %v", editedMap[key]),
+					fmt.Sprintf("#   remote:    %v", remoteMap[key]),
+				)
+				annotated[key] = true
+				break
+			}
+		}
+		out = append(out, line)
+	}
+
+	// A conflicting key that was removed on one side won't have a line of its
+	// own above; list it at the end so it isn't silently dropped.
+	for _, key := range conflicts {
+		if annotated[key] {
+			continue
+		}
+		out = append(out,
+			fmt.Sprintf("# CONFLICT: %q was removed on one side and changed on the other", key),
+			fmt.Sprintf("#   your edit: %v", editedMap[key]),
+			fmt.Sprintf("#   remote:    %v", remoteMap[key]),
+		)
+	}
+
+	return []byte(strings.Join(out, "\n"))
 }
 
 // POST /vedsdk/Credentials/update
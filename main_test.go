@@ -0,0 +1,71 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDetectConflicts(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		edited   string
+		remote   string
+		want     []string
+	}{
+		{
+			name:     "no changes",
+			original: "name: foo\nttl: 60\n",
+			edited:   "name: foo\nttl: 60\n",
+			remote:   "name: foo\nttl: 60\n",
+			want:     nil,
+		},
+		{
+			name:     "only edited changed",
+			original: "name: foo\nttl: 60\n",
+			edited:   "name: foo\nttl: 120\n",
+			remote:   "name: foo\nttl: 60\n",
+			want:     nil,
+		},
+		{
+			name:     "only remote changed",
+			original: "name: foo\nttl: 60\n",
+			edited:   "name: foo\nttl: 60\n",
+			remote:   "name: foo\nttl: 120\n",
+			want:     nil,
+		},
+		{
+			name:     "both changed the same key to the same value",
+			original: "name: foo\nttl: 60\n",
+			edited:   "name: foo\nttl: 120\n",
+			remote:   "name: foo\nttl: 120\n",
+			want:     nil,
+		},
+		{
+			name:     "both changed the same key to different values",
+			original: "name: foo\nttl: 60\n",
+			edited:   "name: foo\nttl: 120\n",
+			remote:   "name: foo\nttl: 300\n",
+			want:     []string{"ttl"},
+		},
+		{
+			name:     "conflict on a key added by both sides",
+			original: "name: foo\n",
+			edited:   "name: foo\nttl: 120\n",
+			remote:   "name: foo\nttl: 300\n",
+			want:     []string{"ttl"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conflicts, _, _, err := detectConflicts([]byte(tt.original), []byte(tt.edited), []byte(tt.remote))
+			if err != nil {
+				t.Fatalf("detectConflicts returned error: %v", err)
+			}
+			if !reflect.DeepEqual(conflicts, tt.want) {
+				t.Errorf("detectConflicts() = %v, want %v", conflicts, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/maelvls/tppctl/proto"
+)
+
+// serveConfig holds the flags accepted by the 'tppctl serve' subcommand.
+type serveConfig struct {
+	addr        string
+	tlsCertFile string
+	tlsKeyFile  string
+	bearerToken string
+	rateLimit   float64 // requests per second, shared across all callers.
+	watchPeriod time.Duration
+}
+
+// runServer starts the TppCtl gRPC service, holding a single TPP token and
+// fanning ListCredentials/GetCredential/UpdateCredential/WatchCredentials RPCs
+// out to the TPP REST API on behalf of callers, so they don't each need to
+// embed the TPP REST client or manage their own token.
+func runServer(tppURL, tppToken string, cfg serveConfig) error {
+	lis, err := net.Listen("tcp", cfg.addr)
+	if err != nil {
+		return fmt.Errorf("while listening on %q: %w", cfg.addr, err)
+	}
+
+	limiter := rate.NewLimiter(rate.Limit(cfg.rateLimit), int(cfg.rateLimit)+1)
+
+	var opts []grpc.ServerOption
+	if cfg.tlsCertFile != "" || cfg.tlsKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.tlsCertFile, cfg.tlsKeyFile)
+		if err != nil {
+			return fmt.Errorf("while loading TLS keypair: %w", err)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{cert}})))
+	}
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(authUnaryInterceptor(cfg.bearerToken), rateLimitUnaryInterceptor(limiter), auditUnaryInterceptor),
+		grpc.ChainStreamInterceptor(authStreamInterceptor(cfg.bearerToken), rateLimitStreamInterceptor(limiter), auditStreamInterceptor),
+	)
+
+	srv := grpc.NewServer(opts...)
+	pb.RegisterTppCtlServer(srv, &tppCtlServer{
+		tppURL:      tppURL,
+		tppToken:    tppToken,
+		watchPeriod: cfg.watchPeriod,
+	})
+
+	log.Printf("tppctl serve: listening on %s", cfg.addr)
+	return srv.Serve(lis)
+}
+
+// tppCtlServer implements pb.TppCtlServer on top of the existing
+// listObjects/getCred/updateCred TPP REST client functions.
+type tppCtlServer struct {
+	pb.UnimplementedTppCtlServer
+	tppURL      string
+	tppToken    string
+	watchPeriod time.Duration
+}
+
+func (s *tppCtlServer) ListCredentials(ctx context.Context, req *pb.ListCredentialsRequest) (*pb.ListCredentialsResponse, error) {
+	credPaths, err := listObjects(s.tppURL, s.tppToken)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "while listing credentials: %v", err)
+	}
+	return &pb.ListCredentialsResponse{CredPaths: credPaths}, nil
+}
+
+func (s *tppCtlServer) GetCredential(ctx context.Context, req *pb.GetCredentialRequest) (*pb.GetCredentialResponse, error) {
+	yamlBlob, err := s.fetchYAML(req.CredPath)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "while getting %q: %v", req.CredPath, err)
+	}
+	return &pb.GetCredentialResponse{Yaml: string(yamlBlob)}, nil
+}
+
+// UpdateCredential saves req.Yaml to req.CredPath, running it through the
+// same checkCredentialUpdate conflict detection and schema validation as
+// 'tppctl edit', so callers going through this sidecar can't silently
+// clobber a concurrent edit or write an invalid document. Conflict detection
+// is skipped when req.BaseYaml is empty, since the caller didn't tell us
+// what they last read.
+func (s *tppCtlServer) UpdateCredential(ctx context.Context, req *pb.UpdateCredentialRequest) (*pb.UpdateCredentialResponse, error) {
+	newYAML := []byte(req.Yaml)
+	// With no BaseYaml, newYAML serves as its own base: no key then counts as
+	// changed on the caller's side, so no conflict can be reported.
+	base := []byte(req.BaseYaml)
+	if len(base) == 0 {
+		base = newYAML
+	}
+	remoteResp, _, conflicts, _, _, errs, err := checkCredentialUpdate(s.tppURL, s.tppToken, req.CredPath, base, newYAML)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "while checking %q before update: %v", req.CredPath, err)
+	}
+	if len(conflicts) > 0 {
+		return nil, status.Errorf(codes.Aborted, "%q was changed concurrently; conflict(s) in key(s): %s", req.CredPath, strings.Join(conflicts, ", "))
+	}
+	if len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%q failed validation: %s", req.CredPath, strings.Join(errs, "; "))
+	}
+
+	remoteResp.Values[0].Value = base64.StdEncoding.EncodeToString(newYAML)
+	if err := updateCred(s.tppURL, s.tppToken, req.CredPath, *remoteResp); err != nil {
+		return nil, status.Errorf(codes.Internal, "while updating %q: %v", req.CredPath, err)
+	}
+	return &pb.UpdateCredentialResponse{}, nil
+}
+
+func (s *tppCtlServer) WatchCredentials(req *pb.WatchCredentialsRequest, stream pb.TppCtl_WatchCredentialsServer) error {
+	last := map[string]string{}
+	ticker := time.NewTicker(s.watchPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-ticker.C:
+			credPaths := req.CredPaths
+			if len(credPaths) == 0 {
+				var err error
+				credPaths, err = listObjects(s.tppURL, s.tppToken)
+				if err != nil {
+					return status.Errorf(codes.Internal, "while listing credentials to watch: %v", err)
+				}
+			}
+			for _, credPath := range credPaths {
+				yamlBlob, err := s.fetchYAML(credPath)
+				if err != nil {
+					return status.Errorf(codes.Internal, "while watching %q: %v", credPath, err)
+				}
+				if string(yamlBlob) == last[credPath] {
+					continue
+				}
+				last[credPath] = string(yamlBlob)
+				if err := stream.Send(&pb.CredentialEvent{CredPath: credPath, Yaml: string(yamlBlob)}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func (s *tppCtlServer) fetchYAML(credPath string) ([]byte, error) {
+	credResp, err := getCred(s.tppURL, s.tppToken, credPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(credResp.Values) == 0 {
+		return nil, fmt.Errorf("no values found in %q", credPath)
+	}
+	return base64.StdEncoding.DecodeString(credResp.Values[0].Value)
+}
+
+// authUnaryInterceptor rejects any call whose 'authorization: Bearer <token>'
+// metadata doesn't match wantToken, so tppctl serve can be deployed as a
+// sidecar without trusting every process on the host.
+func authUnaryInterceptor(wantToken string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkBearerToken(ctx, wantToken); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func authStreamInterceptor(wantToken string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkBearerToken(ss.Context(), wantToken); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func checkBearerToken(ctx context.Context, wantToken string) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return status.Error(codes.Unauthenticated, "missing authorization metadata")
+	}
+	got := strings.TrimPrefix(values[0], "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(got), []byte(wantToken)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+	return nil
+}
+
+// rateLimitUnaryInterceptor applies a single shared token-bucket limiter
+// across all callers, so one misbehaving client can't starve the TPP token
+// the server holds of its request budget.
+func rateLimitUnaryInterceptor(limiter *rate.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !limiter.Allow() {
+			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func rateLimitStreamInterceptor(limiter *rate.Limiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !limiter.Allow() {
+			return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return handler(srv, ss)
+	}
+}
+
+// auditUnaryInterceptor logs one line per RPC: method, duration and outcome,
+// so that actions taken against TPP Generic Credentials through this sidecar
+// are traceable.
+func auditUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	log.Printf("audit: method=%s duration=%s err=%v", info.FullMethod, time.Since(start), err)
+	return resp, err
+}
+
+func auditStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	log.Printf("audit: method=%s duration=%s err=%v", info.FullMethod, time.Since(start), err)
+	return err
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConfigKind discriminates the shape of a Firefly configuration YAML
+// document, read from its top-level 'kind:' field.
+type ConfigKind string
+
+const (
+	KindPolicy         ConfigKind = "policy"
+	KindCAAccount      ConfigKind = "ca-account"
+	KindServiceAccount ConfigKind = "service-account"
+	KindSubjectDN      ConfigKind = "subject-dn-template"
+	KindSAN            ConfigKind = "san"
+)
+
+// Validator checks that a parsed Firefly configuration document has the
+// fields required for its kind. It returns one message per problem found, or
+// nil if the document is valid.
+type Validator func(doc map[string]interface{}) []string
+
+// schemaRegistry maps a 'kind:' discriminator to the validator for that
+// configuration shape. New config kinds can be supported by registering a
+// validator here, without touching editConfigInCred.
+var schemaRegistry = map[ConfigKind]Validator{
+	KindPolicy:         validatePolicy,
+	KindCAAccount:      validateCAAccount,
+	KindServiceAccount: validateServiceAccount,
+	KindSubjectDN:      validateSubjectDNTemplate,
+	KindSAN:            validateSAN,
+}
+
+func requireFields(doc map[string]interface{}, fields ...string) []string {
+	var errs []string
+	for _, f := range fields {
+		if v, ok := doc[f]; !ok || v == nil || v == "" {
+			errs = append(errs, fmt.Sprintf("missing required field %q", f))
+		}
+	}
+	return errs
+}
+
+func validatePolicy(doc map[string]interface{}) []string {
+	return requireFields(doc, "name", "zone")
+}
+
+func validateCAAccount(doc map[string]interface{}) []string {
+	return requireFields(doc, "name", "caType", "credential")
+}
+
+func validateServiceAccount(doc map[string]interface{}) []string {
+	return requireFields(doc, "name", "owner", "scopes")
+}
+
+func validateSubjectDNTemplate(doc map[string]interface{}) []string {
+	return requireFields(doc, "name", "cn")
+}
+
+func validateSAN(doc map[string]interface{}) []string {
+	return requireFields(doc, "type", "value")
+}
+
+// validateConfig parses yamlBlob and runs the validator registered for its
+// 'kind:' discriminator. A document with no 'kind:', or a kind with no
+// registered validator, is passed through unvalidated, since not every
+// Firefly config shape has a schema registered yet.
+func validateConfig(yamlBlob []byte) []string {
+	doc, err := yamlToMap(yamlBlob)
+	if err != nil {
+		return []string{fmt.Sprintf("invalid YAML: %v", err)}
+	}
+
+	kindRaw, ok := doc["kind"]
+	if !ok {
+		return nil
+	}
+	kind, ok := kindRaw.(string)
+	if !ok {
+		return []string{fmt.Sprintf("'kind' must be a string, got %T", kindRaw)}
+	}
+
+	validate, ok := schemaRegistry[ConfigKind(kind)]
+	if !ok {
+		return nil
+	}
+	return validate(doc)
+}
+
+// injectValidationErrors prepends validation errors to doc as editor-visible
+// '# VALIDATION ERROR' comments, so the user sees what to fix when $EDITOR
+// reopens.
+func injectValidationErrors(doc []byte, errs []string) []byte {
+	var b strings.Builder
+	b.WriteString("# Save rejected, please fix the following and save again:\n")
+	for _, e := range errs {
+		b.WriteString("# VALIDATION ERROR: " + e + "\n")
+	}
+	b.Write(doc)
+	return []byte(b.String())
+}
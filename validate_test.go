@@ -0,0 +1,95 @@
+package main
+
+import "testing"
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr bool
+	}{
+		{
+			name:    "no kind passes through unvalidated",
+			yaml:    "name: foo\n",
+			wantErr: false,
+		},
+		{
+			name:    "unknown kind passes through unvalidated",
+			yaml:    "kind: made-up\nname: foo\n",
+			wantErr: false,
+		},
+		{
+			name:    "valid policy",
+			yaml:    "kind: policy\nname: foo\nzone: bar\n",
+			wantErr: false,
+		},
+		{
+			name:    "policy missing required fields",
+			yaml:    "kind: policy\nname: foo\n",
+			wantErr: true,
+		},
+		{
+			name:    "ca-account missing required fields",
+			yaml:    "kind: ca-account\nname: foo\n",
+			wantErr: true,
+		},
+		{
+			name:    "service-account missing required fields",
+			yaml:    "kind: service-account\nname: foo\n",
+			wantErr: true,
+		},
+		{
+			name:    "subject-dn-template missing required fields",
+			yaml:    "kind: subject-dn-template\nname: foo\n",
+			wantErr: true,
+		},
+		{
+			name:    "san missing required fields",
+			yaml:    "kind: san\ntype: dns\n",
+			wantErr: true,
+		},
+		{
+			name:    "multiple errors reported at once",
+			yaml:    "kind: ca-account\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateConfig([]byte(tt.yaml))
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateConfig(%q) = %v, wantErr %v", tt.yaml, errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateConfigMultipleErrors(t *testing.T) {
+	errs := validateConfig([]byte("kind: ca-account\n"))
+	if len(errs) != 3 {
+		t.Fatalf("validateConfig() = %v, want 3 errors (name, caType, credential)", errs)
+	}
+}
+
+func TestRequireFields(t *testing.T) {
+	doc := map[string]interface{}{
+		"name": "foo",
+		"ttl":  "",
+		"zone": nil,
+	}
+	got := requireFields(doc, "name", "ttl", "zone", "missing")
+	want := []string{
+		`missing required field "ttl"`,
+		`missing required field "zone"`,
+		`missing required field "missing"`,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("requireFields() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("requireFields()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}